@@ -0,0 +1,172 @@
+package ast
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Wire-format byte values for the item types this decoder knows how to
+// reconstruct. Each constant is the item's format code already shifted into
+// the top six bits of the header byte; the low two bits of the header byte
+// hold the number of length bytes.
+const (
+	formatList = 0x00
+	formatU8   = 0xA0
+	formatU1   = 0xA4
+	formatU2   = 0xA8
+	formatU4   = 0xB0
+
+	formatCodeMask  = 0xFC
+	lengthBytesMask = 0x03
+)
+
+// DecodeError reports which stage of decoding an item failed, wrapping the
+// underlying cause so callers can match it with errors.Is/errors.As.
+type DecodeError struct {
+	Op  string // "header", "length", or "body"
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("ast: decode %s: %v", e.Op, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	// ErrTruncatedInput is returned when the reader runs out of bytes before
+	// a complete item header or body has been read.
+	ErrTruncatedInput = errors.New("truncated input")
+
+	// ErrInvalidFormatCode is returned when an item header's format code
+	// does not correspond to any item type DecodeItem knows how to build,
+	// or when the header declares zero length bytes.
+	ErrInvalidFormatCode = errors.New("invalid format code")
+
+	// ErrLengthOverflow is returned when an item's encoded size - header
+	// plus declared body length - exceeds MAX_BYTE_SIZE.
+	ErrLengthOverflow = errors.New("length exceeds MAX_BYTE_SIZE")
+)
+
+// Decode is a convenience wrapper around DecodeItem for callers that already
+// hold the full encoded item in memory.
+func Decode(data []byte) (ItemNode, error) {
+	return DecodeItem(bytes.NewReader(data))
+}
+
+// DecodeItem reads one SECS-II item - header plus body - from r and
+// reconstructs the concrete ItemNode it describes, recursing into nested
+// list items as needed.
+//
+// DecodeItem is the inverse of ItemNode.ToBytes(): encoding a node produced
+// by DecodeItem and decoding the result again yields an equal node.
+func DecodeItem(r io.Reader) (ItemNode, error) {
+	header, err := readByte(r)
+	if err != nil {
+		return nil, &DecodeError{"header", err}
+	}
+
+	formatCode := header & formatCodeMask
+	numLengthBytes := int(header & lengthBytesMask)
+	if numLengthBytes == 0 || numLengthBytes > 3 {
+		return nil, &DecodeError{"header", ErrInvalidFormatCode}
+	}
+
+	length, err := readLength(r, numLengthBytes)
+	if err != nil {
+		return nil, &DecodeError{"length", err}
+	}
+	if 1+numLengthBytes+length > MAX_BYTE_SIZE {
+		return nil, &DecodeError{"length", ErrLengthOverflow}
+	}
+
+	if formatCode == formatList {
+		items := make([]ItemNode, 0, length)
+		for i := 0; i < length; i++ {
+			item, err := DecodeItem(r)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return NewListNode(items...), nil
+	}
+
+	byteSize, ok := uintByteSize(formatCode)
+	if !ok {
+		return nil, &DecodeError{"header", ErrInvalidFormatCode}
+	}
+	if length%byteSize != 0 {
+		return nil, &DecodeError{"body", fmt.Errorf("length %d is not a multiple of byte size %d", length, byteSize)}
+	}
+
+	count := length / byteSize
+	values := make([]interface{}, 0, count)
+	buf := make([]byte, byteSize)
+	for i := 0; i < count; i++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, &DecodeError{"body", ErrTruncatedInput}
+		}
+		values = append(values, decodeUint(buf))
+	}
+
+	return NewUintNode(byteSize, values...), nil
+}
+
+// uintByteSize maps a U* format code to the byteSize NewUintNode expects.
+func uintByteSize(formatCode byte) (int, bool) {
+	switch formatCode {
+	case formatU1:
+		return 1, true
+	case formatU2:
+		return 2, true
+	case formatU4:
+		return 4, true
+	case formatU8:
+		return 8, true
+	default:
+		return 0, false
+	}
+}
+
+// decodeUint interprets buf as a big-endian unsigned integer.
+func decodeUint(buf []byte) uint64 {
+	switch len(buf) {
+	case 1:
+		return uint64(buf[0])
+	case 2:
+		return uint64(binary.BigEndian.Uint16(buf))
+	case 4:
+		return uint64(binary.BigEndian.Uint32(buf))
+	case 8:
+		return binary.BigEndian.Uint64(buf)
+	default:
+		return 0
+	}
+}
+
+func readByte(r io.Reader) (byte, error) {
+	var buf [1]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, ErrTruncatedInput
+	}
+	return buf[0], nil
+}
+
+func readLength(r io.Reader, numBytes int) (int, error) {
+	buf := make([]byte, numBytes)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return 0, ErrTruncatedInput
+	}
+
+	length := 0
+	for _, b := range buf {
+		length = length<<8 | int(b)
+	}
+	return length, nil
+}
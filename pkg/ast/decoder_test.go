@@ -0,0 +1,85 @@
+package ast
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecodeItemRoundTripUint(t *testing.T) {
+	tests := []struct {
+		name     string
+		byteSize int
+		values   []interface{}
+	}{
+		{"u1 empty", 1, []interface{}{}},
+		{"u1 values", 1, []interface{}{0, 1, 255}},
+		{"u2 values", 2, []interface{}{0, 1, 65535}},
+		{"u4 values", 4, []interface{}{0, 1, 4294967295}},
+		{"u8 values", 8, []interface{}{uint64(0), uint64(1), uint64(18446744073709551615)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := NewUintNode(tt.byteSize, tt.values...)
+
+			decoded, err := Decode(original.ToBytes())
+			if err != nil {
+				t.Fatalf("Decode() returned error: %v", err)
+			}
+
+			if decoded.String() != original.String() {
+				t.Errorf("round trip mismatch: got %v, want %v", decoded, original)
+			}
+		})
+	}
+}
+
+func TestDecodeItemRoundTripList(t *testing.T) {
+	original := NewListNode(
+		NewUintNode(1, 1, 2),
+		NewUintNode(4, 100, 200, 300),
+		NewListNode(NewUintNode(2, 7)),
+	)
+
+	decoded, err := Decode(original.ToBytes())
+	if err != nil {
+		t.Fatalf("Decode() returned error: %v", err)
+	}
+
+	if decoded.String() != original.String() {
+		t.Errorf("round trip mismatch: got %v, want %v", decoded, original)
+	}
+}
+
+func TestDecodeItemTruncatedHeader(t *testing.T) {
+	if _, err := Decode([]byte{}); !errors.Is(err, ErrTruncatedInput) {
+		t.Errorf("expected ErrTruncatedInput, got %v", err)
+	}
+}
+
+func TestDecodeItemTruncatedBody(t *testing.T) {
+	original := NewUintNode(4, 1, 2, 3)
+	encoded := original.ToBytes()
+
+	if _, err := Decode(encoded[:len(encoded)-1]); !errors.Is(err, ErrTruncatedInput) {
+		t.Errorf("expected ErrTruncatedInput, got %v", err)
+	}
+}
+
+func TestDecodeItemInvalidFormatCode(t *testing.T) {
+	// 0x04 carries format code 1, which no item type here uses, and declares
+	// zero length bytes.
+	if _, err := Decode([]byte{0x04, 0x00}); !errors.Is(err, ErrInvalidFormatCode) {
+		t.Errorf("expected ErrInvalidFormatCode, got %v", err)
+	}
+}
+
+func TestDecodeItemLengthOverflow(t *testing.T) {
+	// MAX_BYTE_SIZE counts the header too, so a body length that still fits
+	// a 3-byte length field overflows once the 4 header bytes are added.
+	length := MAX_BYTE_SIZE - 3
+	header := []byte{formatU1 | 0x03, byte(length >> 16), byte(length >> 8), byte(length)}
+	if _, err := Decode(header); !errors.Is(err, ErrLengthOverflow) {
+		t.Errorf("expected ErrLengthOverflow, got %v", err)
+	}
+}
@@ -0,0 +1,80 @@
+package ast
+
+import "fmt"
+
+// ErrByteSize indicates that a constructor received a byteSize that the
+// item type does not support, or one with which the given number of values
+// would encode to more than MAX_BYTE_SIZE bytes.
+type ErrByteSize struct {
+	ByteSize int
+}
+
+func (e *ErrByteSize) Error() string {
+	return fmt.Sprintf("ast: invalid byte size %d", e.ByteSize)
+}
+
+// ErrValueOverflow indicates that the value at Index cannot be represented
+// within ByteSize bytes.
+type ErrValueOverflow struct {
+	Index    int
+	Value    uint64
+	ByteSize int
+}
+
+func (e *ErrValueOverflow) Error() string {
+	return fmt.Sprintf("ast: value %d at index %d overflows byte size %d", e.Value, e.Index, e.ByteSize)
+}
+
+// ErrInvalidVariableName indicates that the variable name at Index does not
+// adhere to the variable naming rule described in interface.go.
+type ErrInvalidVariableName struct {
+	Index int
+	Name  string
+}
+
+func (e *ErrInvalidVariableName) Error() string {
+	return fmt.Sprintf("ast: invalid variable name %q at index %d", e.Name, e.Index)
+}
+
+// ErrDuplicateVariablePosition indicates that two variables were declared at
+// the same position, Index.
+type ErrDuplicateVariablePosition struct {
+	Index int
+}
+
+func (e *ErrDuplicateVariablePosition) Error() string {
+	return fmt.Sprintf("ast: duplicate variable position %d", e.Index)
+}
+
+// ErrUnsupportedType indicates that the value at Index is not a type the
+// constructor knows how to convert.
+type ErrUnsupportedType struct {
+	Index int
+	Value interface{}
+}
+
+func (e *ErrUnsupportedType) Error() string {
+	return fmt.Sprintf("ast: unsupported type %T at index %d", e.Value, e.Index)
+}
+
+// ErrVariablePositionOverflow indicates that a variable was declared at
+// Index, a position outside the data array's bounds.
+type ErrVariablePositionOverflow struct {
+	Index int
+}
+
+func (e *ErrVariablePositionOverflow) Error() string {
+	return fmt.Sprintf("ast: variable position %d is out of range", e.Index)
+}
+
+// ErrVariableValueNotZero indicates that the data array holds a stale,
+// non-zero Value at Index even though a variable is declared there; variable
+// positions must carry the zero value since they're filled in later.
+type ErrVariableValueNotZero struct {
+	Index int
+	Value uint64
+}
+
+func (e *ErrVariableValueNotZero) Error() string {
+	return fmt.Sprintf("ast: value %d at variable position %d is not zero", e.Value, e.Index)
+}
@@ -0,0 +1,82 @@
+package ast
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// varNamePattern matches the variable naming rule described on ItemNode: a
+// leading letter followed by any number of letters, digits, or underscores.
+var varNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// isValidVarName reports whether name adheres to the variable naming rule
+// described on ItemNode.
+func isValidVarName(name string) bool {
+	return varNamePattern.MatchString(name)
+}
+
+// getVariableNames returns the variable names in variables, in no
+// particular order.
+func getVariableNames(variables map[string]int) []string {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	return names
+}
+
+// formatCodeAndByteSize returns the format code and per-value byte size for
+// a format tag ("u1", "u2", "u4", "u8", or "l"). For "l" the byte size is 1,
+// since a list's length field counts items directly rather than bytes.
+func formatCodeAndByteSize(tag string) (code byte, byteSize int, ok bool) {
+	switch tag {
+	case "u1":
+		return formatU1, 1, true
+	case "u2":
+		return formatU2, 2, true
+	case "u4":
+		return formatU4, 4, true
+	case "u8":
+		return formatU8, 8, true
+	case "l":
+		return formatList, 1, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// getHeaderBytes returns the SECS-II item header - format byte followed by
+// 1-3 length bytes - for an item of the given format tag holding count
+// values. For scalar tags ("u1", "u2", "u4", "u8") the length field carries
+// the values' total byte length; for "l" it carries count directly, per the
+// SECS-II list format.
+func getHeaderBytes(tag string, count int) ([]byte, error) {
+	code, byteSize, ok := formatCodeAndByteSize(tag)
+	if !ok {
+		return nil, fmt.Errorf("ast: unknown format tag %q", tag)
+	}
+
+	length := count * byteSize
+	n := numLengthBytes(length)
+
+	header := make([]byte, 0, 1+n)
+	header = append(header, code|byte(n))
+	for i := n - 1; i >= 0; i-- {
+		header = append(header, byte(length>>(uint(i)*8)))
+	}
+	return header, nil
+}
+
+// getDataByteLength returns the total encoded byte length - header plus
+// body - of an item of the given format tag holding count values. It is
+// used to enforce MAX_BYTE_SIZE at construction time, before an oversized
+// node is ever encoded.
+func getDataByteLength(tag string, count int) int {
+	_, byteSize, ok := formatCodeAndByteSize(tag)
+	if !ok {
+		return 0
+	}
+
+	bodyLength := count * byteSize
+	return 1 + numLengthBytes(bodyLength) + bodyLength
+}
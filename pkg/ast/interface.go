@@ -0,0 +1,41 @@
+package ast
+
+// ItemNode is the common interface implemented by every SECS-II data item
+// node (UintNode, ListNode, and so on). An ItemNode tree mirrors the
+// structure of a SECS-II message body: scalar items hold typed values, and
+// list items hold an ordered sequence of child ItemNodes.
+//
+// A node's values may be left as named variables instead of concrete values;
+// FillValues substitutes concrete values for those variables before the node
+// can be encoded. A variable name must start with a letter and contain only
+// letters, digits, and underscores thereafter.
+type ItemNode interface {
+	// Size returns the number of values (or, for a list, child items) the
+	// node holds.
+	Size() int
+
+	// Variables returns the names of every variable this node, or any of
+	// its descendants, still has unfilled.
+	Variables() []string
+
+	// FillValues returns a new ItemNode with each variable named in values
+	// replaced by the corresponding value. Variables not present in values
+	// are left unfilled.
+	FillValues(values map[string]interface{}) ItemNode
+
+	// ToBytes encodes the node to its SECS-II wire representation. It
+	// returns an empty slice if the node still has unfilled variables.
+	ToBytes() []byte
+
+	// AppendBytes encodes the node and appends the result to dst, returning
+	// the extended slice.
+	AppendBytes(dst []byte) []byte
+
+	// String returns a human-readable representation of the node.
+	String() string
+}
+
+// MAX_BYTE_SIZE is the largest encoded byte length - header plus body - a
+// single SECS-II item may have, matching the maximum a 3-byte length field
+// can address.
+const MAX_BYTE_SIZE = 0xFFFFFF
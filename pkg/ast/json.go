@@ -0,0 +1,162 @@
+package ast
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// uintJSON is the on-the-wire JSON shape for a UintNode, e.g.
+// {"type":"U4","values":[1,2,3],"variables":{"lotId":1}}.
+type uintJSON struct {
+	Type      string         `json:"type"`
+	Values    []uint64       `json:"values"`
+	Variables map[string]int `json:"variables,omitempty"`
+}
+
+// listJSON is the on-the-wire JSON shape for a list item, e.g.
+// {"type":"L","items":[...]}. Items are decoded lazily so each one can be
+// dispatched to its own concrete type via UnmarshalItemJSON.
+type listJSON struct {
+	Type  string            `json:"type"`
+	Items []json.RawMessage `json:"items"`
+}
+
+// MarshalJSON implements json.Marshaler, producing the self-describing
+// {"type":"U<N>","values":[...],"variables":{...}} schema used to log and
+// replay SECS-II traffic.
+func (node *UintNode) MarshalJSON() ([]byte, error) {
+	values := make([]uint64, node.Size())
+	copy(values, node.values)
+
+	return json.Marshal(uintJSON{
+		Type:      strings.ToUpper(uintTag(node.byteSize)),
+		Values:    values,
+		Variables: node.variables,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rebuilding a UintNode from the
+// schema MarshalJSON produces, preserving variable positions and byteSize.
+func (node *UintNode) UnmarshalJSON(data []byte) error {
+	var raw uintJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	byteSize, ok := uintByteSizeForTag(raw.Type)
+	if !ok {
+		return fmt.Errorf("ast: unknown UintNode type %q", raw.Type)
+	}
+
+	values := make([]interface{}, len(raw.Values))
+	for i, v := range raw.Values {
+		values[i] = v
+	}
+	for name, pos := range raw.Variables {
+		if !(0 <= pos && pos < len(values)) {
+			return fmt.Errorf("ast: variable %q position %d is out of range [0, %d)", name, pos, len(values))
+		}
+		values[pos] = name
+	}
+
+	built, err := NewUintNodeChecked(byteSize, values...)
+	if err != nil {
+		return err
+	}
+
+	*node = *(built.(*UintNode))
+	return nil
+}
+
+// uintByteSizeForTag maps a "U<N>" JSON type tag back to the byteSize
+// NewUintNode expects.
+func uintByteSizeForTag(tag string) (int, bool) {
+	switch tag {
+	case "U1":
+		return 1, true
+	case "U2":
+		return 2, true
+	case "U4":
+		return 4, true
+	case "U8":
+		return 8, true
+	default:
+		return 0, false
+	}
+}
+
+// MarshalJSON implements json.Marshaler, producing the self-describing
+// {"type":"L","items":[...]} schema, with each item marshaled via its own
+// MarshalJSON implementation.
+func (node *ListNode) MarshalJSON() ([]byte, error) {
+	items := make([]json.RawMessage, node.Size())
+	for i, item := range node.items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = data
+	}
+
+	return json.Marshal(listJSON{Type: "L", Items: items})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rebuilding a ListNode from the
+// schema MarshalJSON produces, dispatching each item to its own concrete
+// type via UnmarshalItemJSON.
+func (node *ListNode) UnmarshalJSON(data []byte) error {
+	item, err := UnmarshalItemJSON(data)
+	if err != nil {
+		return err
+	}
+
+	list, ok := item.(*ListNode)
+	if !ok {
+		return fmt.Errorf("ast: expected a list item, got %T", item)
+	}
+
+	*node = *list
+	return nil
+}
+
+// UnmarshalItemJSON decodes data into the concrete ItemNode its "type" tag
+// identifies, recursing into nested list items as needed. It is the JSON
+// counterpart to DecodeItem: where DecodeItem rebuilds a tree from the
+// SECS-II wire format, UnmarshalItemJSON rebuilds it from the canonical JSON
+// schema used for logging, replay, and cross-language tooling.
+func UnmarshalItemJSON(data []byte) (ItemNode, error) {
+	var envelope struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+
+	if envelope.Type == "L" {
+		var raw listJSON
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+
+		items := make([]ItemNode, 0, len(raw.Items))
+		for _, itemData := range raw.Items {
+			item, err := UnmarshalItemJSON(itemData)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return NewListNode(items...), nil
+	}
+
+	if _, ok := uintByteSizeForTag(envelope.Type); ok {
+		node := &UintNode{}
+		if err := node.UnmarshalJSON(data); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+
+	return nil, fmt.Errorf("ast: unknown item type %q", envelope.Type)
+}
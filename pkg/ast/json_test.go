@@ -0,0 +1,74 @@
+package ast
+
+import (
+	"testing"
+)
+
+func TestUintNodeJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		byteSize int
+		values   []interface{}
+	}{
+		{"u1 values", 1, []interface{}{0, 1, 255}},
+		{"u2 values", 2, []interface{}{0, 1, 65535}},
+		{"u4 with variable", 4, []interface{}{1, "lotId", 3}},
+		{"u8 values", 8, []interface{}{uint64(0), uint64(18446744073709551615)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := NewUintNode(tt.byteSize, tt.values...)
+
+			data, err := original.(*UintNode).MarshalJSON()
+			if err != nil {
+				t.Fatalf("MarshalJSON() returned error: %v", err)
+			}
+
+			decoded, err := UnmarshalItemJSON(data)
+			if err != nil {
+				t.Fatalf("UnmarshalItemJSON() returned error: %v", err)
+			}
+
+			if decoded.String() != original.String() {
+				t.Errorf("round trip mismatch: got %v, want %v", decoded, original)
+			}
+		})
+	}
+}
+
+func TestListNodeJSONRoundTrip(t *testing.T) {
+	original := NewListNode(
+		NewUintNode(1, 1, 2),
+		NewUintNode(4, 100, "lotId", 300),
+		NewListNode(NewUintNode(2, 7)),
+	)
+
+	data, err := original.(*ListNode).MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned error: %v", err)
+	}
+
+	decoded, err := UnmarshalItemJSON(data)
+	if err != nil {
+		t.Fatalf("UnmarshalItemJSON() returned error: %v", err)
+	}
+
+	if decoded.String() != original.String() {
+		t.Errorf("round trip mismatch: got %v, want %v", decoded, original)
+	}
+}
+
+func TestUnmarshalItemJSONUnknownType(t *testing.T) {
+	_, err := UnmarshalItemJSON([]byte(`{"type":"BOOLEAN","values":[true]}`))
+	if err == nil {
+		t.Fatal("expected an error for an unknown item type")
+	}
+}
+
+func TestUnmarshalItemJSONVariablePositionOutOfRange(t *testing.T) {
+	_, err := UnmarshalItemJSON([]byte(`{"type":"U4","values":[1,2],"variables":{"lotId":10}}`))
+	if err == nil {
+		t.Fatal("expected an error for an out-of-range variable position")
+	}
+}
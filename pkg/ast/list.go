@@ -0,0 +1,84 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ListNode is an immutable data type that represents an ordered list of
+// items in a SECS-II message. Implements ItemNode.
+type ListNode struct {
+	items []ItemNode
+
+	// Rep invariants
+	// - items should not contain a nil ItemNode.
+}
+
+// Factory methods
+
+// NewListNode creates a new ListNode containing items, in order.
+func NewListNode(items ...ItemNode) ItemNode {
+	nodeItems := make([]ItemNode, len(items))
+	copy(nodeItems, items)
+	return &ListNode{nodeItems}
+}
+
+// Public methods
+
+// Size implements ItemNode.Size().
+func (node *ListNode) Size() int {
+	return len(node.items)
+}
+
+// Variables implements ItemNode.Variables().
+func (node *ListNode) Variables() []string {
+	names := make([]string, 0)
+	for _, item := range node.items {
+		names = append(names, item.Variables()...)
+	}
+	return names
+}
+
+// FillValues implements ItemNode.FillValues().
+func (node *ListNode) FillValues(values map[string]interface{}) ItemNode {
+	filled := make([]ItemNode, node.Size())
+	for i, item := range node.items {
+		filled[i] = item.FillValues(values)
+	}
+	return NewListNode(filled...)
+}
+
+// ToBytes implements ItemNode.ToBytes(). It is a thin wrapper over
+// AppendBytes for callers that don't need to manage their own buffer.
+func (node *ListNode) ToBytes() []byte {
+	return node.AppendBytes(nil)
+}
+
+// AppendBytes implements ItemNode.AppendBytes(). It encodes the node and
+// appends the result to dst, returning the extended slice.
+func (node *ListNode) AppendBytes(dst []byte) []byte {
+	if len(node.Variables()) != 0 {
+		return dst
+	}
+
+	header, err := getHeaderBytes("l", node.Size())
+	if err != nil {
+		return dst
+	}
+	dst = append(dst, header...)
+
+	for _, item := range node.items {
+		dst = item.AppendBytes(dst)
+	}
+
+	return dst
+}
+
+// String returns the string representation of the node.
+func (node *ListNode) String() string {
+	items := make([]string, 0, node.Size())
+	for _, item := range node.items {
+		items = append(items, item.String())
+	}
+	return fmt.Sprintf("<L[%d] %v>", node.Size(), strings.Join(items, " "))
+}
@@ -0,0 +1,27 @@
+package ast
+
+import "sync"
+
+// bufferPool recycles the backing slices used to stage encoded items,
+// letting a busy HSMS session serialize many messages per second without a
+// fresh allocation for each one.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 256)
+		return &buf
+	},
+}
+
+// AcquireBuffer returns a zero-length byte slice from the shared pool.
+// Callers should pass the result to ItemNode.AppendBytes and return it with
+// ReleaseBuffer once they are done with the encoded bytes.
+func AcquireBuffer() []byte {
+	buf := *(bufferPool.Get().(*[]byte))
+	return buf[:0]
+}
+
+// ReleaseBuffer returns buf to the shared pool for reuse. buf must not be
+// used again after calling ReleaseBuffer.
+func ReleaseBuffer(buf []byte) {
+	bufferPool.Put(&buf)
+}
@@ -1,11 +1,84 @@
 package ast
 
 import (
+	"encoding/binary"
 	"fmt"
 	"strconv"
 	"strings"
 )
 
+// uintTagBySize caches the "u<N>" format tag for each supported byteSize so
+// ToBytes/AppendBytes don't pay for an fmt.Sprintf on every call.
+var uintTagBySize = map[int]string{
+	1: "u1",
+	2: "u2",
+	4: "u4",
+	8: "u8",
+}
+
+// uintTag returns the format tag for byteSize, falling back to formatting
+// it directly for byte sizes checkRep would reject anyway.
+func uintTag(byteSize int) string {
+	if tag, ok := uintTagBySize[byteSize]; ok {
+		return tag
+	}
+	return fmt.Sprintf("u%d", byteSize)
+}
+
+// uintFormatCode maps byteSize to the item's format code, shifted into the
+// top six bits of the header byte as DecodeItem expects.
+var uintFormatCode = map[int]byte{
+	1: formatU1,
+	2: formatU2,
+	4: formatU4,
+	8: formatU8,
+}
+
+// uintHeaderByte caches the complete first header byte (format code | number
+// of length bytes) for every (byteSize, numLengthBytes) combination that
+// appendUintHeader can produce, so AppendBytes never has to recompute it.
+var uintHeaderByte = func() map[int]byte {
+	cache := make(map[int]byte, len(uintFormatCode)*3)
+	for byteSize, code := range uintFormatCode {
+		for n := 1; n <= 3; n++ {
+			cache[byteSize<<2|n] = code | byte(n)
+		}
+	}
+	return cache
+}()
+
+// numLengthBytes returns how many length bytes a SECS-II header needs to
+// encode count, per the 1/2/3-byte length field layout.
+func numLengthBytes(count int) int {
+	switch {
+	case count <= 0xFF:
+		return 1
+	case count <= 0xFFFF:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// appendUintHeader appends the cached header byte and length bytes for a
+// UintNode of byteSize holding count values to dst, returning the extended
+// slice and whether byteSize was recognized. The length field carries the
+// body's byte length (count*byteSize), per the SECS-II item header format.
+func appendUintHeader(dst []byte, byteSize, count int) ([]byte, bool) {
+	byteLength := count * byteSize
+	n := numLengthBytes(byteLength)
+	header, ok := uintHeaderByte[byteSize<<2|n]
+	if !ok {
+		return dst, false
+	}
+
+	dst = append(dst, header)
+	for i := n - 1; i >= 0; i-- {
+		dst = append(dst, byte(byteLength>>(uint(i)*8)))
+	}
+	return dst, true
+}
+
 // UintNode is a immutable data type that represents an unsigned integer in a SECS-II message.
 // Implements DataItemNode.
 type UintNode struct {
@@ -27,9 +100,29 @@ type UintNode struct {
 // The byteSize should be either 1, 2, 4, or 8.
 // Each input of the values should be an unsigned integer that could be represented within bytes of the byteSize,
 // or it should be a string with a valid variable name as specified in the interface documentation.
+//
+// NewUintNode panics if the input is invalid; callers that need to validate
+// untrusted input should use NewUintNodeChecked instead.
 func NewUintNode(byteSize int, values ...interface{}) ItemNode {
-	if getDataByteLength(fmt.Sprintf("u%d", byteSize), len(values)) > MAX_BYTE_SIZE {
-		panic("item node size limit exceeded")
+	node, err := NewUintNodeChecked(byteSize, values...)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// NewUintNodeChecked is the error-returning counterpart to NewUintNode. It
+// performs the same construction but reports invalid input as an error
+// instead of panicking, so callers can safely build nodes from data they do
+// not trust (e.g. values parsed off the wire).
+//
+// The returned error is one of *ErrByteSize, *ErrValueOverflow,
+// *ErrInvalidVariableName, *ErrDuplicateVariablePosition,
+// *ErrVariablePositionOverflow, *ErrVariableValueNotZero, or
+// *ErrUnsupportedType, identifying the offending index and value.
+func NewUintNodeChecked(byteSize int, values ...interface{}) (ItemNode, error) {
+	if getDataByteLength(uintTag(byteSize), len(values)) > MAX_BYTE_SIZE {
+		return nil, &ErrByteSize{byteSize}
 	}
 
 	var (
@@ -64,13 +157,15 @@ func NewUintNode(byteSize int, values ...interface{}) ItemNode {
 			nodeValues = append(nodeValues, 0)
 			nodeVariables[v] = i
 		default:
-			panic("input argument contains invalid type for UintNode")
+			return nil, &ErrUnsupportedType{i, value}
 		}
 	}
 
 	node := &UintNode{byteSize, nodeValues, nodeVariables}
-	node.checkRep()
-	return node
+	if err := node.checkRep(); err != nil {
+		return nil, err
+	}
+	return node, nil
 }
 
 // Public methods
@@ -101,32 +196,41 @@ func (node *UintNode) FillValues(values map[string]interface{}) ItemNode {
 	return NewUintNode(node.byteSize, nodeValues...)
 }
 
-// ToBytes implements ItemNode.ToBytes()
+// ToBytes implements ItemNode.ToBytes(). It is a thin wrapper over
+// AppendBytes for callers that don't need to manage their own buffer.
 func (node *UintNode) ToBytes() []byte {
+	return node.AppendBytes(nil)
+}
+
+// AppendBytes implements ItemNode.AppendBytes(). It encodes the node and
+// appends the result to dst, returning the extended slice, so callers
+// serializing many items (e.g. a busy HSMS session) can reuse a single
+// buffer instead of allocating one per call. See AcquireBuffer/ReleaseBuffer
+// for a pooled source of such buffers.
+func (node *UintNode) AppendBytes(dst []byte) []byte {
 	if len(node.variables) != 0 {
-		return []byte{}
+		return dst
 	}
 
-	result, err := getHeaderBytes(fmt.Sprintf("u%d", node.byteSize), node.Size())
-	if err != nil {
-		return []byte{}
+	dst, ok := appendUintHeader(dst, node.byteSize, node.Size())
+	if !ok {
+		return dst
 	}
 
 	for _, value := range node.values {
-		// Initialize mask; mask == 0xFF000000 when node.byteSize == 4
-		var mask uint64 = 0xFF << ((node.byteSize - 1) * 8)
-		for i := 0; i < node.byteSize; i++ {
-			// Calculate and append value's i-th byte
-			// e.g. given value == 0x01ABCDEF, node.ByteSize == 4,
-			//      ithByte == 0x01 when i == 0
-			//      ithByte == 0xAB when i == 1
-			var ithByte byte = byte((value & mask) >> ((node.byteSize - i - 1) * 8))
-			result = append(result, ithByte)
-			mask = mask >> 8
+		switch node.byteSize {
+		case 1:
+			dst = append(dst, byte(value))
+		case 2:
+			dst = binary.BigEndian.AppendUint16(dst, uint16(value))
+		case 4:
+			dst = binary.BigEndian.AppendUint32(dst, uint32(value))
+		case 8:
+			dst = binary.BigEndian.AppendUint64(dst, value)
 		}
 	}
 
-	return result
+	return dst
 }
 
 // String returns the string representation of the node.
@@ -149,35 +253,40 @@ func (node *UintNode) String() string {
 
 // Private methods
 
-func (node *UintNode) checkRep() {
+// checkRep validates the node's representation invariants, returning the
+// first violation it finds instead of panicking so NewUintNodeChecked can
+// surface it to the caller.
+func (node *UintNode) checkRep() error {
 	if node.byteSize != 1 && node.byteSize != 2 &&
 		node.byteSize != 4 && node.byteSize != 8 {
-		panic("invalid byte size")
+		return &ErrByteSize{node.byteSize}
 	}
 
-	for _, v := range node.values {
+	for i, v := range node.values {
 		if !(0 <= v && v <= uint64(1<<(node.byteSize*8)-1)) {
-			panic("value overflow")
+			return &ErrValueOverflow{i, v, node.byteSize}
 		}
 	}
 
 	visited := map[int]bool{}
 	for name, pos := range node.variables {
+		if !(0 <= pos && pos < node.Size()) {
+			return &ErrVariablePositionOverflow{pos}
+		}
+
 		if node.values[pos] != 0 {
-			panic("value in variable position isn't a zero-value")
+			return &ErrVariableValueNotZero{pos, node.values[pos]}
 		}
 
 		if !isValidVarName(name) {
-			panic("invalid variable name")
+			return &ErrInvalidVariableName{pos, name}
 		}
 
 		if _, ok := visited[pos]; ok {
-			panic("variable position is not unique")
+			return &ErrDuplicateVariablePosition{pos}
 		}
 		visited[pos] = true
-
-		if !(0 <= pos && pos < node.Size()) {
-			panic("variable position overflow")
-		}
 	}
-}
\ No newline at end of file
+
+	return nil
+}
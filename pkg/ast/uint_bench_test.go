@@ -0,0 +1,51 @@
+package ast
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAppendUintHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		byteSize int
+		count    int
+		want     []byte
+	}{
+		{"u1 short list", 1, 2, []byte{formatU1 | 0x01, 0x02}},
+		{"u4 long list", 4, 0x1234, []byte{formatU4 | 0x02, 0x48, 0xD0}},
+		{"u8 empty", 8, 0, []byte{formatU8 | 0x01, 0x00}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := appendUintHeader(nil, tt.byteSize, tt.count)
+			if !ok {
+				t.Fatalf("appendUintHeader() returned ok=false")
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("appendUintHeader() = % X, want % X", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkUintNodeToBytes(b *testing.B) {
+	node := NewUintNode(4, 1, 2, 3, 4, 5, 6, 7, 8)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = node.ToBytes()
+	}
+}
+
+func BenchmarkUintNodeAppendBytes(b *testing.B) {
+	node := NewUintNode(4, 1, 2, 3, 4, 5, 6, 7, 8).(*UintNode)
+	buf := AcquireBuffer()
+	defer ReleaseBuffer(buf)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = node.AppendBytes(buf[:0])
+	}
+}
@@ -0,0 +1,70 @@
+package ast
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewUintNodeCheckedValid(t *testing.T) {
+	node, err := NewUintNodeChecked(4, 1, 2, "lotId")
+	if err != nil {
+		t.Fatalf("NewUintNodeChecked() returned error: %v", err)
+	}
+	if node.Size() != 3 {
+		t.Errorf("Size() = %d, want 3", node.Size())
+	}
+}
+
+func TestNewUintNodeCheckedErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		byteSize int
+		values   []interface{}
+		isTarget func(error) bool
+	}{
+		{"bad byte size", 3, []interface{}{1}, func(err error) bool {
+			var target *ErrByteSize
+			return errors.As(err, &target)
+		}},
+		{"value overflow", 1, []interface{}{256}, func(err error) bool {
+			var target *ErrValueOverflow
+			return errors.As(err, &target)
+		}},
+		{"invalid variable name", 4, []interface{}{"1bad"}, func(err error) bool {
+			var target *ErrInvalidVariableName
+			return errors.As(err, &target)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewUintNodeChecked(tt.byteSize, tt.values...)
+			if err == nil {
+				t.Fatalf("NewUintNodeChecked() returned no error")
+			}
+			if !tt.isTarget(err) {
+				t.Errorf("got error %v (%T), want a different concrete error type", err, err)
+			}
+		})
+	}
+}
+
+func TestNewUintNodeCheckedUnsupportedType(t *testing.T) {
+	_, err := NewUintNodeChecked(4, 3.14)
+	var target *ErrUnsupportedType
+	if !errors.As(err, &target) {
+		t.Fatalf("got error %v (%T), want *ErrUnsupportedType", err, err)
+	}
+	if target.Index != 0 {
+		t.Errorf("Index = %d, want 0", target.Index)
+	}
+}
+
+func TestNewUintNodePanicsOnInvalidInput(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("NewUintNode() did not panic on invalid input")
+		}
+	}()
+	NewUintNode(3, 1)
+}